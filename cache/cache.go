@@ -0,0 +1,94 @@
+// Package cache provides an on-disk cache of the last reported diff
+// fingerprint per project pair, so that dtdiff can avoid re-reporting
+// diffs that haven't changed since the previous run.
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Store is a JSON-backed cache mapping a project pair key to the
+// fingerprint of the last diff reported for that pair.
+type Store struct {
+	path string
+
+	mu      sync.Mutex
+	entries map[string]string
+}
+
+// Open loads a Store from path, returning an empty one if the file doesn't
+// exist yet.
+func Open(path string) (*Store, error) {
+	s := &Store{path: path, entries: make(map[string]string)}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+
+		return nil, fmt.Errorf("failed to read cache %s: %w", path, err)
+	}
+
+	if err = json.Unmarshal(data, &s.entries); err != nil {
+		return nil, fmt.Errorf("failed to parse cache %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Key builds the cache key identifying a project pair.
+func Key(aUUID, bUUID uuid.UUID) string {
+	return aUUID.String() + "_" + bUUID.String()
+}
+
+// Seen reports whether fingerprint is the last one recorded for key.
+func (s *Store) Seen(key, fingerprint string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.entries[key] == fingerprint
+}
+
+// Put records fingerprint as the last diff reported for key.
+func (s *Store) Put(key, fingerprint string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = fingerprint
+}
+
+// Prune removes entries whose key is not in keep, e.g. because the project
+// pair no longer exists in either instance.
+func (s *Store) Prune(keep map[string]struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key := range s.entries {
+		if _, ok := keep[key]; !ok {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// Flush persists the store to disk.
+func (s *Store) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.MarshalIndent(s.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache: %w", err)
+	}
+
+	if err = os.WriteFile(s.path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write cache %s: %w", s.path, err)
+	}
+
+	return nil
+}
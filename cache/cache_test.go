@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestOpen_MissingFileReturnsEmptyStore(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+
+	if s.Seen("any-key", "any-fingerprint") {
+		t.Error("expected an empty store to have seen nothing")
+	}
+}
+
+func TestKey(t *testing.T) {
+	a := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	b := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	want := a.String() + "_" + b.String()
+	if got := Key(a, b); got != want {
+		t.Errorf("Key = %q, want %q", got, want)
+	}
+}
+
+func TestStore_PutSeen(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "cache.json"))
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+
+	if s.Seen("pair-1", "fingerprint-1") {
+		t.Error("expected fingerprint not to have been seen yet")
+	}
+
+	s.Put("pair-1", "fingerprint-1")
+
+	if !s.Seen("pair-1", "fingerprint-1") {
+		t.Error("expected fingerprint to be seen after Put")
+	}
+	if s.Seen("pair-1", "fingerprint-2") {
+		t.Error("expected a different fingerprint for the same key not to be seen")
+	}
+}
+
+func TestStore_FlushAndReopenRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+
+	s, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	s.Put("pair-1", "fingerprint-1")
+
+	if err = s.Flush(); err != nil {
+		t.Fatalf("Flush returned error: %v", err)
+	}
+
+	if _, err = os.Stat(path); err != nil {
+		t.Fatalf("expected cache file to exist after Flush: %v", err)
+	}
+
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	if !reopened.Seen("pair-1", "fingerprint-1") {
+		t.Error("expected the reopened store to have seen the flushed fingerprint")
+	}
+}
+
+func TestStore_Prune(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "cache.json"))
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	s.Put("keep", "fingerprint-1")
+	s.Put("drop", "fingerprint-2")
+
+	s.Prune(map[string]struct{}{"keep": {}})
+
+	if !s.Seen("keep", "fingerprint-1") {
+		t.Error("expected the kept key to survive Prune")
+	}
+	if s.Seen("drop", "fingerprint-2") {
+		t.Error("expected the dropped key not to survive Prune")
+	}
+}
@@ -0,0 +1,157 @@
+// Package diff builds a structured, finding-level model of how two
+// projects' findings differ, and serializes it to various machine-readable
+// formats.
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// ChangeType describes how a finding differs between instance A and B.
+type ChangeType string
+
+const (
+	Added   ChangeType = "added"
+	Removed ChangeType = "removed"
+	Changed ChangeType = "changed"
+)
+
+// FindingKey uniquely identifies a finding within a project, independent of
+// which instance it was retrieved from.
+type FindingKey struct {
+	ComponentPURL string `json:"componentPurl"`
+	VulnID        string `json:"vulnId"`
+}
+
+// FindingDiff describes a single difference between the findings of two
+// projects.
+type FindingDiff struct {
+	Key    FindingKey `json:"key"`
+	Change ChangeType `json:"change"`
+
+	// A and B hold the finding as seen in instance A and B respectively.
+	// Exactly one is nil for Added and Removed changes.
+	A *dtrack.Finding `json:"a,omitempty"`
+	B *dtrack.Finding `json:"b,omitempty"`
+
+	// Reason explains why this difference survived the normalization
+	// pipeline, so that remaining discrepancies are explainable rather than
+	// just a raw before/after dump.
+	Reason string `json:"reason"`
+}
+
+// ProjectDiff is the structured result of comparing the findings of a
+// single project between instance A and instance B.
+type ProjectDiff struct {
+	AProject dtrack.Project `json:"aProject"`
+	BProject dtrack.Project `json:"bProject"`
+
+	Findings []FindingDiff `json:"findings"`
+
+	// KeyCollisions counts findings on either side that share a FindingKey
+	// (the same component PURL and vulnerability ID) with another finding on
+	// that same side, e.g. components without a PURL. Colliding findings
+	// overwrite one another in indexFindings, so a non-zero value means this
+	// diff may be missing a finding that was silently dropped rather than
+	// compared.
+	KeyCollisions int `json:"keyCollisions,omitempty"`
+}
+
+// Compare builds the FindingDiff model for a pair of finding sets, keyed by
+// component PURL and vulnerability ID.
+func Compare(aProject, bProject dtrack.Project, aFindings, bFindings []dtrack.Finding) ProjectDiff {
+	aIndex, aCollisions := indexFindings(aFindings)
+	bIndex, bCollisions := indexFindings(bFindings)
+
+	pd := ProjectDiff{AProject: aProject, BProject: bProject, KeyCollisions: aCollisions + bCollisions}
+
+	for key, aFinding := range aIndex {
+		aFinding := aFinding
+
+		bFinding, inB := bIndex[key]
+		if !inB {
+			pd.Findings = append(pd.Findings, FindingDiff{
+				Key: key, Change: Removed, A: &aFinding,
+				Reason: "finding remains in A, but was not found in B after normalization",
+			})
+			continue
+		}
+
+		if !findingsEqual(aFinding, bFinding) {
+			bFinding := bFinding
+			pd.Findings = append(pd.Findings, FindingDiff{
+				Key: key, Change: Changed, A: &aFinding, B: &bFinding,
+				Reason: changeReason(aFinding, bFinding),
+			})
+		}
+	}
+
+	for key, bFinding := range bIndex {
+		bFinding := bFinding
+		if _, inA := aIndex[key]; !inA {
+			pd.Findings = append(pd.Findings, FindingDiff{
+				Key: key, Change: Added, B: &bFinding,
+				Reason: "finding is new in B and has no counterpart in A after normalization",
+			})
+		}
+	}
+
+	sort.Slice(pd.Findings, func(i, j int) bool {
+		if pd.Findings[i].Key.ComponentPURL == pd.Findings[j].Key.ComponentPURL {
+			return pd.Findings[i].Key.VulnID < pd.Findings[j].Key.VulnID
+		}
+
+		return pd.Findings[i].Key.ComponentPURL < pd.Findings[j].Key.ComponentPURL
+	})
+
+	return pd
+}
+
+// indexFindings builds a FindingKey-indexed map of findings, and reports how
+// many findings collided with one already present under the same key (and so
+// were silently overwritten by the later one) so callers can surface the
+// data loss instead of letting it pass unnoticed.
+func indexFindings(findings []dtrack.Finding) (map[FindingKey]dtrack.Finding, int) {
+	index := make(map[FindingKey]dtrack.Finding, len(findings))
+	collisions := 0
+
+	for _, f := range findings {
+		key := FindingKey{ComponentPURL: f.Component.PURL, VulnID: f.Vulnerability.VulnID}
+		if _, exists := index[key]; exists {
+			collisions++
+		}
+
+		index[key] = f
+	}
+
+	return index, collisions
+}
+
+// findingsEqual compares two findings for the same key structurally, since
+// dynamic fields are expected to have already been cleared by the caller.
+func findingsEqual(a, b dtrack.Finding) bool {
+	aJSON, _ := json.Marshal(a)
+	bJSON, _ := json.Marshal(b)
+
+	return bytes.Equal(aJSON, bJSON)
+}
+
+// changeReason explains the most salient difference between two findings
+// for the same key, to help distinguish an actual discrepancy from residual
+// noise the normalization pipeline didn't account for.
+func changeReason(a, b dtrack.Finding) string {
+	if a.Vulnerability.Severity != b.Vulnerability.Severity {
+		return fmt.Sprintf("severity differs: %s in A vs. %s in B", a.Vulnerability.Severity, b.Vulnerability.Severity)
+	}
+
+	if a.Analysis.State != b.Analysis.State {
+		return fmt.Sprintf("analysis state differs: %s in A vs. %s in B", a.Analysis.State, b.Analysis.State)
+	}
+
+	return "finding details differ after normalization"
+}
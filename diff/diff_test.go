@@ -0,0 +1,108 @@
+package diff
+
+import (
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+func TestCompare_DetectsKeyCollisions(t *testing.T) {
+	var aFirst, aSecond dtrack.Finding
+	aFirst.Component.Name = "component-one"
+	aFirst.Vulnerability.VulnID = "CVE-2024-0001"
+	aSecond.Component.Name = "component-two"
+	aSecond.Vulnerability.VulnID = "CVE-2024-0001"
+	// Both findings share the zero-value ComponentPURL, so they collide on
+	// the same FindingKey despite being distinct components.
+	aFindings := []dtrack.Finding{aFirst, aSecond}
+
+	var b dtrack.Finding
+	b.Component.Name = "component-one"
+	b.Vulnerability.VulnID = "CVE-2024-0001"
+	bFindings := []dtrack.Finding{b}
+
+	pd := Compare(dtrack.Project{}, dtrack.Project{}, aFindings, bFindings)
+
+	if pd.KeyCollisions != 1 {
+		t.Errorf("KeyCollisions = %d, want 1", pd.KeyCollisions)
+	}
+}
+
+func TestCompare_NoCollisionsWhenKeysAreUnique(t *testing.T) {
+	var a dtrack.Finding
+	a.Component.PURL = "pkg:maven/foo/bar@1.0.0"
+	a.Vulnerability.VulnID = "CVE-2024-0001"
+
+	pd := Compare(dtrack.Project{}, dtrack.Project{}, []dtrack.Finding{a}, []dtrack.Finding{a})
+
+	if pd.KeyCollisions != 0 {
+		t.Errorf("KeyCollisions = %d, want 0", pd.KeyCollisions)
+	}
+	if len(pd.Findings) != 0 {
+		t.Errorf("expected identical findings to produce no diff, got %d", len(pd.Findings))
+	}
+}
+
+func TestCompare_ChangeReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   dtrack.Finding
+		change ChangeType
+		want   string
+	}{
+		{
+			name:   "removed",
+			change: Removed,
+			want:   "finding remains in A, but was not found in B after normalization",
+		},
+		{
+			name:   "added",
+			change: Added,
+			want:   "finding is new in B and has no counterpart in A after normalization",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var aFindings, bFindings []dtrack.Finding
+
+			var f dtrack.Finding
+			f.Component.PURL = "pkg:maven/foo/bar@1.0.0"
+			f.Vulnerability.VulnID = "CVE-2024-0001"
+
+			switch tt.change {
+			case Removed:
+				aFindings = []dtrack.Finding{f}
+			case Added:
+				bFindings = []dtrack.Finding{f}
+			}
+
+			pd := Compare(dtrack.Project{}, dtrack.Project{}, aFindings, bFindings)
+			if len(pd.Findings) != 1 {
+				t.Fatalf("expected 1 finding diff, got %d", len(pd.Findings))
+			}
+			if pd.Findings[0].Reason != tt.want {
+				t.Errorf("Reason = %q, want %q", pd.Findings[0].Reason, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompare_ChangeReasonForSeverityDifference(t *testing.T) {
+	var a, b dtrack.Finding
+	a.Component.PURL = "pkg:maven/foo/bar@1.0.0"
+	a.Vulnerability.VulnID = "CVE-2024-0001"
+	a.Vulnerability.Severity = "HIGH"
+	b = a
+	b.Vulnerability.Severity = "CRITICAL"
+
+	pd := Compare(dtrack.Project{}, dtrack.Project{}, []dtrack.Finding{a}, []dtrack.Finding{b})
+	if len(pd.Findings) != 1 {
+		t.Fatalf("expected 1 finding diff, got %d", len(pd.Findings))
+	}
+
+	want := "severity differs: HIGH in A vs. CRITICAL in B"
+	if pd.Findings[0].Reason != want {
+		t.Errorf("Reason = %q, want %q", pd.Findings[0].Reason, want)
+	}
+}
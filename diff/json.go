@@ -0,0 +1,8 @@
+package diff
+
+import "encoding/json"
+
+// ToJSON serializes diffs as an indented JSON array of ProjectDiff.
+func ToJSON(diffs []ProjectDiff) ([]byte, error) {
+	return json.MarshalIndent(diffs, "", "  ")
+}
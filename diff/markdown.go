@@ -0,0 +1,31 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToMarkdown renders diffs as a Markdown report, one section per project.
+func ToMarkdown(diffs []ProjectDiff) string {
+	var sb strings.Builder
+
+	for _, pd := range diffs {
+		fmt.Fprintf(&sb, "## %s/%s\n\n", pd.AProject.Name, pd.AProject.Version)
+
+		if len(pd.Findings) == 0 {
+			sb.WriteString("No differences.\n\n")
+			continue
+		}
+
+		sb.WriteString("| Change | Component | Vulnerability | Reason |\n")
+		sb.WriteString("| --- | --- | --- | --- |\n")
+
+		for _, fd := range pd.Findings {
+			fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n", fd.Change, fd.Key.ComponentPURL, fd.Key.VulnID, fd.Reason)
+		}
+
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,73 @@
+package diff
+
+// SeverityMetrics aggregates finding changes for a single severity level.
+type SeverityMetrics struct {
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+	Changed int `json:"changed"`
+}
+
+// Metrics aggregates finding changes across all projects compared in a run.
+type Metrics struct {
+	ProjectsCompared  int `json:"projectsCompared"`
+	ProjectsDiffering int `json:"projectsDiffering"`
+
+	FindingsAdded   int `json:"findingsAdded"`
+	FindingsRemoved int `json:"findingsRemoved"`
+	FindingsChanged int `json:"findingsChanged"`
+
+	BySeverity map[string]SeverityMetrics `json:"bySeverity"`
+
+	// KeyCollisions is the total number of FindingKey collisions observed
+	// across the run (see ProjectDiff.KeyCollisions), summed over every
+	// project pair examined, including ones with no reported differences.
+	KeyCollisions int `json:"keyCollisions,omitempty"`
+}
+
+// NewMetrics aggregates Metrics over diffs, which should only contain
+// projects whose findings actually differ. projectsCompared is the total
+// number of matched project pairs examined in the run, including ones with
+// no differences.
+func NewMetrics(diffs []ProjectDiff, projectsCompared int) Metrics {
+	m := Metrics{
+		ProjectsCompared:  projectsCompared,
+		ProjectsDiffering: len(diffs),
+		BySeverity:        make(map[string]SeverityMetrics),
+	}
+
+	for _, pd := range diffs {
+		m.KeyCollisions += pd.KeyCollisions
+
+		for _, fd := range pd.Findings {
+			sev := severityOf(fd)
+			sm := m.BySeverity[sev]
+
+			switch fd.Change {
+			case Added:
+				m.FindingsAdded++
+				sm.Added++
+			case Removed:
+				m.FindingsRemoved++
+				sm.Removed++
+			case Changed:
+				m.FindingsChanged++
+				sm.Changed++
+			}
+
+			m.BySeverity[sev] = sm
+		}
+	}
+
+	return m
+}
+
+func severityOf(fd FindingDiff) string {
+	switch {
+	case fd.B != nil:
+		return string(fd.B.Vulnerability.Severity)
+	case fd.A != nil:
+		return string(fd.A.Vulnerability.Severity)
+	default:
+		return "UNKNOWN"
+	}
+}
@@ -0,0 +1,107 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// sarifLog is a minimal SARIF 2.1.0 log, sufficient to represent dtdiff's
+// finding differences as results.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// ToSARIF renders diffs as a SARIF 2.1.0 log, with one result per changed
+// finding across all projects.
+func ToSARIF(diffs []ProjectDiff) ([]byte, error) {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "dtdiff",
+				InformationURI: "https://github.com/nscuro/dtdiff",
+			},
+		},
+	}
+
+	seenRules := make(map[string]struct{})
+
+	for _, pd := range diffs {
+		for _, fd := range pd.Findings {
+			if _, ok := seenRules[fd.Key.VulnID]; !ok {
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: fd.Key.VulnID})
+				seenRules[fd.Key.VulnID] = struct{}{}
+			}
+
+			run.Results = append(run.Results, sarifResult{
+				RuleID: fd.Key.VulnID,
+				Level:  sarifLevel(fd.Change),
+				Message: sarifMessage{
+					Text: fmt.Sprintf("%s: finding for %s was %s between %s/%s and its counterpart (%s)",
+						fd.Key.VulnID, fd.Key.ComponentPURL, fd.Change, pd.AProject.Name, pd.AProject.Version, fd.Reason),
+				},
+				Locations: []sarifLocation{{
+					LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: fd.Key.ComponentPURL}},
+				}},
+			})
+		}
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+
+	return json.MarshalIndent(log, "", "  ")
+}
+
+func sarifLevel(change ChangeType) string {
+	switch change {
+	case Added:
+		return "error"
+	case Removed:
+		return "note"
+	default:
+		return "warning"
+	}
+}
@@ -2,16 +2,20 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"flag"
 	"fmt"
 	dtrack "github.com/DependencyTrack/client-go"
-	"github.com/google/uuid"
 	"github.com/gowebpki/jcs"
+	"github.com/nscuro/dtdiff/cache"
+	"github.com/nscuro/dtdiff/diff"
+	"github.com/nscuro/dtdiff/matching"
+	"github.com/nscuro/dtdiff/normalize"
+	"github.com/nscuro/dtdiff/reporting"
 	"github.com/nsf/jsondiff"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
 	"sort"
@@ -26,12 +30,17 @@ type comparisonPair struct {
 
 func main() {
 	var (
-		aURL        string
-		aAPIKey     string
-		bURL        string
-		bAPIKey     string
-		concurrency int
-		outDir      string
+		aURL            string
+		aAPIKey         string
+		bURL            string
+		bAPIKey         string
+		concurrency     int
+		outDir          string
+		reportingConfig string
+		reportingDir    string
+		forceReport     bool
+		matchStrategy   string
+		format          string
 	)
 	flag.StringVar(&aURL, "url-a", "", "API URL for Dependency-Track instance A")
 	flag.StringVar(&aAPIKey, "apikey-a", "", "API URL for Dependency-Track instance A")
@@ -39,8 +48,37 @@ func main() {
 	flag.StringVar(&bAPIKey, "apikey-b", "", "API key for Dependency-Track instance B")
 	flag.IntVar(&concurrency, "concurrency", 5, "Maximum comparison concurrency")
 	flag.StringVar(&outDir, "out", "", "Path to write output files to")
+	flag.StringVar(&reportingConfig, "reporting-config", "", "Path to a reporting config file (YAML/JSON); defaults to writing HTML files to -out")
+	flag.StringVar(&reportingDir, "reporting-directory", "", "Directory to keep dtdiff's diff-dedup cache in; disabled if empty")
+	flag.BoolVar(&forceReport, "force-report", false, "Report diffs even if they were already reported in a previous run")
+	flag.StringVar(&matchStrategy, "match-strategy", "name-version", "Project matching strategy to use (name-version, purl, tag, cpe, swid, composite)")
+	flag.StringVar(&format, "format", "html", "Comma-separated list of output formats to write to -out (html, json, sarif, md)")
 	flag.Parse()
 
+	var reportingCfg reporting.Config
+	if reportingConfig != "" {
+		cfg, loadErr := reporting.LoadConfig(reportingConfig)
+		if loadErr != nil {
+			log.Fatalf("failed to load reporting config: %v", loadErr)
+		}
+		reportingCfg = *cfg
+	}
+
+	reporters, err := loadReporters(reportingCfg, outDir, format)
+	if err != nil {
+		log.Fatalf("failed to initialize reporters: %v", err)
+	}
+
+	pipeline := reportingCfg.Pipeline()
+
+	var cacheStore *cache.Store
+	if reportingDir != "" {
+		cacheStore, err = cache.Open(filepath.Join(reportingDir, "dtdiff-cache.json"))
+		if err != nil {
+			log.Fatalf("failed to open diff-dedup cache in %s: %v", reportingDir, err)
+		}
+	}
+
 	aClient, err := dtrack.NewClient(aURL, dtrack.WithAPIKey(aAPIKey))
 	if err != nil {
 		log.Fatalf("failed to initialize client for %s: %v", aURL, err)
@@ -63,10 +101,29 @@ func main() {
 		return
 	}
 
-	log.Println("match projects from %s with projects in %s", aURL, bURL)
-	comparisonPairs, err := matchProjectComparisonPairs(bClient, aProjects)
+	log.Printf("collecting projects from %s", bURL)
+	bProjects, err := collectProjects(bClient)
+	if err != nil {
+		log.Fatalf("failed to collect projects from %s: %v", bURL, err)
+	}
+
+	log.Printf("collected %d projects from %s", len(bProjects), bURL)
+
+	matcher, err := matching.New(matchStrategy, bClient, bProjects)
 	if err != nil {
-		log.Fatalf("failed to match projects from %s with projects in %s: %v", aURL, bURL, err)
+		log.Fatalf("failed to initialize matcher: %v", err)
+	}
+
+	log.Printf("matching projects from %s with projects in %s using %q strategy", aURL, bURL, matcher.Name())
+	comparisonPairs, unmatched := matchProjectComparisonPairs(matcher, aProjects)
+
+	if len(unmatched) > 0 {
+		unmatchedPath := filepath.Join(outDir, "unmatched.json")
+		if err = matching.WriteUnmatchedReport(unmatchedPath, unmatched); err != nil {
+			log.Printf("failed to write unmatched-projects report: %v", err)
+		} else {
+			log.Printf("wrote unmatched-projects report for %d projects to %s", len(unmatched), unmatchedPath)
+		}
 	}
 
 	if len(comparisonPairs) == 0 {
@@ -76,11 +133,12 @@ func main() {
 
 	wg := sync.WaitGroup{}
 	pairChan := make(chan comparisonPair, 1)
+	metricsCollector := newMetricsCollector()
 
 	log.Printf("launching %d comparison workers", concurrency)
 	for i := 0; i < concurrency; i++ {
 		wg.Add(1)
-		go doCompare(pairChan, &wg, aClient, bClient, outDir)
+		go doCompare(pairChan, &wg, aClient, bClient, pipeline, reporters, cacheStore, forceReport, metricsCollector)
 	}
 
 	for _, pair := range comparisonPairs {
@@ -89,9 +147,98 @@ func main() {
 	close(pairChan)
 
 	wg.Wait()
+
+	summary := diff.NewMetrics(metricsCollector.diffs, len(comparisonPairs))
+	summary.KeyCollisions += metricsCollector.collisions
+	summaryData, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		log.Printf("failed to marshal run summary: %v", err)
+	} else if err = os.WriteFile(filepath.Join(outDir, "summary.json"), summaryData, os.ModePerm); err != nil {
+		log.Printf("failed to write run summary: %v", err)
+	}
+
+	if cacheStore != nil {
+		keep := make(map[string]struct{}, len(comparisonPairs))
+		for _, pair := range comparisonPairs {
+			keep[cache.Key(pair.aProject.UUID, pair.bProject.UUID)] = struct{}{}
+		}
+
+		cacheStore.Prune(keep)
+		if err = cacheStore.Flush(); err != nil {
+			log.Printf("failed to persist diff-dedup cache: %v", err)
+		}
+	}
+
 	log.Println("all done")
 }
 
+// loadReporters builds the set of reporters to dispatch diffs to: one local
+// file reporter per format listed in formats, plus whatever reporters cfg
+// enables (its HTML section and any trackers).
+func loadReporters(cfg reporting.Config, outDir, formats string) ([]reporting.Reporter, error) {
+	var reporters []reporting.Reporter
+
+	for _, format := range strings.Split(formats, ",") {
+		switch strings.TrimSpace(format) {
+		case "html":
+			reporters = append(reporters, reporting.NewHTMLReporter(reporting.HTMLConfig{Directory: outDir}))
+		case "json":
+			reporters = append(reporters, reporting.NewJSONReporter(reporting.JSONConfig{Directory: outDir}))
+		case "sarif":
+			reporters = append(reporters, reporting.NewSARIFReporter(reporting.SARIFConfig{Directory: outDir}))
+		case "md":
+			reporters = append(reporters, reporting.NewMarkdownReporter(reporting.MarkdownConfig{Directory: outDir}))
+		case "":
+			// Allow trailing/empty entries from a loose "a,b," list.
+		default:
+			return nil, fmt.Errorf("unknown output format %q", format)
+		}
+	}
+
+	trackerReporters, err := cfg.Reporters()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(reporters, trackerReporters...), nil
+}
+
+// metricsCollector gathers the structured ProjectDiff produced for every
+// differing project across all comparison workers, so that a single
+// aggregate summary can be written once the run completes.
+type metricsCollector struct {
+	mu sync.Mutex
+
+	diffs []diff.ProjectDiff
+
+	// collisions counts FindingKey collisions (see diff.ProjectDiff.KeyCollisions)
+	// observed on project pairs whose findings otherwise came out equal, and
+	// so never get added via add.
+	collisions int
+}
+
+func newMetricsCollector() *metricsCollector {
+	return &metricsCollector{}
+}
+
+func (c *metricsCollector) add(pd diff.ProjectDiff) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.diffs = append(c.diffs, pd)
+}
+
+func (c *metricsCollector) addCollisions(n int) {
+	if n == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.collisions += n
+}
+
 func collectProjects(c *dtrack.Client) ([]dtrack.Project, error) {
 	projects := make([]dtrack.Project, 0)
 
@@ -111,17 +258,24 @@ func collectProjects(c *dtrack.Client) ([]dtrack.Project, error) {
 	return projects, nil
 }
 
-func matchProjectComparisonPairs(c *dtrack.Client, projects []dtrack.Project) ([]comparisonPair, error) {
+func matchProjectComparisonPairs(matcher matching.Matcher, projects []dtrack.Project) ([]comparisonPair, []matching.Unmatched) {
 	pairs := make([]comparisonPair, 0)
+	var unmatched []matching.Unmatched
 
 	for _, project := range projects {
-		match, err := c.Project.Lookup(context.Background(), project.Name, project.Version)
+		match, ok, err := matcher.Match(context.Background(), project)
 		if err != nil {
-			var apiErr *dtrack.APIError
-			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
-				log.Printf("failed to lookup project %s/%s: %v", project.Name, project.Version, err)
+			log.Printf("failed to match project %s/%s: %v", project.Name, project.Version, err)
+			continue
+		}
+
+		if !ok {
+			var reason string
+			if rp, ok := matcher.(matching.ReasonProvider); ok {
+				reason = rp.MatchReason(context.Background(), project)
 			}
 
+			unmatched = append(unmatched, matching.Unmatched{Project: project, Strategy: matcher.Name(), Reason: reason})
 			continue
 		}
 
@@ -131,10 +285,10 @@ func matchProjectComparisonPairs(c *dtrack.Client, projects []dtrack.Project) ([
 		})
 	}
 
-	return pairs, nil
+	return pairs, unmatched
 }
 
-func doCompare(pairs <-chan comparisonPair, wg *sync.WaitGroup, ac, bc *dtrack.Client, outDir string) {
+func doCompare(pairs <-chan comparisonPair, wg *sync.WaitGroup, ac, bc *dtrack.Client, pipeline normalize.Pipeline, reporters []reporting.Reporter, cacheStore *cache.Store, forceReport bool, metrics *metricsCollector) {
 	defer wg.Done()
 
 	for pair := range pairs {
@@ -152,10 +306,10 @@ func doCompare(pairs <-chan comparisonPair, wg *sync.WaitGroup, ac, bc *dtrack.C
 			continue
 		}
 
-		// Null-ify fields that will always be different due to their dynamic nature.
-		// This will include UUIDs, timestamps, etc.
-		clearDynamicFields(aFindingPage.Items)
-		clearDynamicFields(bFindingPage.Items)
+		// Run the normalization pipeline (dynamic-field clearing plus any
+		// configured filters) so that expected noise doesn't surface as a diff.
+		aFindingPage.Items = pipeline.Normalize(context.Background(), aFindingPage.Items)
+		bFindingPage.Items = pipeline.Normalize(context.Background(), bFindingPage.Items)
 
 		// Sort findings by component name and vulnerability ID so that the diff will
 		// not be polluted with positional differences.
@@ -186,21 +340,67 @@ func doCompare(pairs <-chan comparisonPair, wg *sync.WaitGroup, ac, bc *dtrack.C
 			continue
 		}
 
+		structuredDiff := diff.Compare(pair.aProject, pair.bProject, aFindingPage.Items, bFindingPage.Items)
+		if structuredDiff.KeyCollisions > 0 {
+			log.Printf("WARNING: %d finding key collision(s) detected for %s/%s; some findings may have been silently dropped before comparison",
+				structuredDiff.KeyCollisions, pair.aProject.Name, pair.aProject.Version)
+		}
+
+		if len(structuredDiff.Findings) == 0 {
+			metrics.addCollisions(structuredDiff.KeyCollisions)
+			log.Printf("findings for %s/%s are equal", pair.aProject.Name, pair.aProject.Version)
+			continue
+		}
+
+		log.Printf("findings for %s/%s are different", pair.aProject.Name, pair.aProject.Version)
+		metrics.add(structuredDiff)
+
 		diffOpts := jsondiff.DefaultHTMLOptions()
-		diffType, diffStr := jsondiff.Compare(aFindingsJCS, bFindingsJCS, &diffOpts)
-		if diffType != jsondiff.FullMatch {
-			log.Printf("findings for %s/%s are different", pair.aProject.Name, pair.aProject.Version)
-			diffPath := filepath.Join(outDir, strings.ReplaceAll(fmt.Sprintf("%s_%s.html", pair.aProject.Name, pair.aProject.Version), "/", "-"))
-			err = os.WriteFile(diffPath, []byte(fmt.Sprintf("<pre>%s</pre>", diffStr)), os.ModePerm)
-			if err != nil {
-				log.Printf("failed to write diff output: %v", err)
+		_, diffStr := jsondiff.Compare(aFindingsJCS, bFindingsJCS, &diffOpts)
+
+		fp := fingerprint(aFindingsJCS, bFindingsJCS)
+		projectDiff := reporting.ProjectDiff{
+			AProject:    pair.aProject,
+			BProject:    pair.bProject,
+			AFindings:   aFindingPage.Items,
+			BFindings:   bFindingPage.Items,
+			Diff:        diffStr,
+			Structured:  structuredDiff,
+			Fingerprint: fp,
+		}
+
+		pairKey := cache.Key(pair.aProject.UUID, pair.bProject.UUID)
+		alreadyReported := !forceReport && cacheStore != nil && cacheStore.Seen(pairKey, fp)
+		if alreadyReported {
+			log.Printf("diff for %s/%s was already reported, skipping trackers", pair.aProject.Name, pair.aProject.Version)
+		}
+
+		for _, reporter := range reporters {
+			// Local (file-based) reporters are exempt from dedup: they're cheap
+			// to regenerate and still useful for local inspection even when no
+			// tracker issue is filed.
+			if _, isLocal := reporter.(reporting.LocalReporter); alreadyReported && !isLocal {
+				continue
+			}
+
+			if err = reporter.Report(context.Background(), projectDiff); err != nil {
+				log.Printf("failed to report diff for %s/%s: %v", pair.aProject.Name, pair.aProject.Version, err)
 			}
-		} else {
-			log.Printf("findings for %s/%s are equal", pair.aProject.Name, pair.aProject.Version)
+		}
+
+		if cacheStore != nil {
+			cacheStore.Put(pairKey, fp)
 		}
 	}
 }
 
+// fingerprint computes a stable hash over a pair of canonicalized finding
+// exports, used to identify the same diff across runs.
+func fingerprint(aFindingsJCS, bFindingsJCS []byte) string {
+	sum := sha256.Sum256(append(aFindingsJCS, bFindingsJCS...))
+	return hex.EncodeToString(sum[:])
+}
+
 func sortCompareFindings(findings []dtrack.Finding) func(int, int) bool {
 	return func(i int, j int) bool {
 		fl := findings[i]
@@ -213,14 +413,3 @@ func sortCompareFindings(findings []dtrack.Finding) func(int, int) bool {
 		return fl.Component.Name < fr.Component.Name
 	}
 }
-
-func clearDynamicFields(findings []dtrack.Finding) {
-	for i := range findings {
-		findings[i].Component.UUID = uuid.Nil
-		findings[i].Component.Project = uuid.Nil
-		findings[i].Vulnerability.UUID = uuid.Nil
-		findings[i].Attribution.UUID = uuid.Nil
-		findings[i].Attribution.AttributedOn = 0
-		findings[i].Matrix = ""
-	}
-}
@@ -0,0 +1,55 @@
+package matching
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// CompositeMatcher tries a sequence of Matchers in order, returning the
+// first match found.
+type CompositeMatcher struct {
+	matchers []Matcher
+}
+
+func NewCompositeMatcher(matchers ...Matcher) *CompositeMatcher {
+	return &CompositeMatcher{matchers: matchers}
+}
+
+func (m *CompositeMatcher) Name() string {
+	return "composite"
+}
+
+func (m *CompositeMatcher) Match(ctx context.Context, project dtrack.Project) (dtrack.Project, bool, error) {
+	for _, matcher := range m.matchers {
+		match, ok, err := matcher.Match(ctx, project)
+		if err != nil {
+			return dtrack.Project{}, false, err
+		}
+		if ok {
+			return match, true, nil
+		}
+	}
+
+	return dtrack.Project{}, false, nil
+}
+
+// MatchReason explains a composite miss by reporting why each sub-matcher
+// missed in turn, so an unmatched-project report is as diagnostic as running
+// each strategy individually would have been.
+func (m *CompositeMatcher) MatchReason(ctx context.Context, project dtrack.Project) string {
+	reasons := make([]string, 0, len(m.matchers))
+
+	for _, matcher := range m.matchers {
+		if rp, ok := matcher.(ReasonProvider); ok {
+			reasons = append(reasons, rp.MatchReason(ctx, project))
+			continue
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s: no match", matcher.Name()))
+	}
+
+	return strings.Join(reasons, "; ")
+}
@@ -0,0 +1,69 @@
+package matching
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// staticMatcher is a minimal Matcher stub for exercising CompositeMatcher in
+// isolation from the real strategies.
+type staticMatcher struct {
+	name  string
+	match dtrack.Project
+	ok    bool
+	err   error
+}
+
+func (m *staticMatcher) Name() string { return m.name }
+
+func (m *staticMatcher) Match(_ context.Context, _ dtrack.Project) (dtrack.Project, bool, error) {
+	return m.match, m.ok, m.err
+}
+
+func TestCompositeMatcher_ReturnsFirstMatch(t *testing.T) {
+	composite := NewCompositeMatcher(
+		&staticMatcher{name: "first", ok: false},
+		&staticMatcher{name: "second", match: dtrack.Project{Name: "b-project"}, ok: true},
+		&staticMatcher{name: "third", match: dtrack.Project{Name: "unreachable"}, ok: true},
+	)
+
+	match, ok, err := composite.Match(context.Background(), dtrack.Project{})
+	if err != nil {
+		t.Fatalf("Match returned error: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if match.Name != "b-project" {
+		t.Errorf("match.Name = %q, want %q", match.Name, "b-project")
+	}
+}
+
+func TestCompositeMatcher_PropagatesSubMatcherError(t *testing.T) {
+	wantErr := errors.New("boom")
+	composite := NewCompositeMatcher(&staticMatcher{name: "first", err: wantErr})
+
+	_, _, err := composite.Match(context.Background(), dtrack.Project{})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("err = %v, want %v", err, wantErr)
+	}
+}
+
+// TestCompositeMatcher_MatchReason covers both the ReasonProvider case and
+// the fallback for a sub-matcher that doesn't implement it.
+func TestCompositeMatcher_MatchReason(t *testing.T) {
+	candidates := []dtrack.Project{{PURL: "pkg:maven/foo/bar@1.0.0"}}
+	composite := NewCompositeMatcher(
+		NewPURLMatcher(candidates),
+		&staticMatcher{name: "opaque", ok: false},
+	)
+
+	got := composite.MatchReason(context.Background(), dtrack.Project{})
+	want := `purl: project has no PURL; opaque: no match`
+	if got != want {
+		t.Errorf("MatchReason = %q, want %q", got, want)
+	}
+}
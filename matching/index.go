@@ -0,0 +1,91 @@
+package matching
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// keyFunc extracts the identity key a matcher indexes candidate projects by.
+// An empty key means the project doesn't participate in that strategy.
+type keyFunc func(dtrack.Project) string
+
+// indexMatcher matches projects by looking up a single extracted key (PURL,
+// CPE, SWID tag ID, or "dtdiff:key=..." tag) in a pre-built index of
+// instance B's projects.
+type indexMatcher struct {
+	name  string
+	field string
+	key   keyFunc
+	index map[string]dtrack.Project
+}
+
+func newIndexMatcher(name, field string, key keyFunc, candidates []dtrack.Project) *indexMatcher {
+	index := make(map[string]dtrack.Project, len(candidates))
+	for _, p := range candidates {
+		if k := key(p); k != "" {
+			index[k] = p
+		}
+	}
+
+	return &indexMatcher{name: name, field: field, key: key, index: index}
+}
+
+func (m *indexMatcher) Name() string {
+	return m.name
+}
+
+func (m *indexMatcher) Match(_ context.Context, project dtrack.Project) (dtrack.Project, bool, error) {
+	k := m.key(project)
+	if k == "" {
+		return dtrack.Project{}, false, nil
+	}
+
+	match, ok := m.index[k]
+	return match, ok, nil
+}
+
+// MatchReason explains why project didn't match: either it has no value for
+// the matcher's field, or no candidate in B shares the value it does have.
+func (m *indexMatcher) MatchReason(_ context.Context, project dtrack.Project) string {
+	k := m.key(project)
+	if k == "" {
+		return fmt.Sprintf("%s: project has no %s", m.name, m.field)
+	}
+
+	return fmt.Sprintf("%s: no project in B with %s %q", m.name, m.field, k)
+}
+
+// NewPURLMatcher matches projects by their Package URL.
+func NewPURLMatcher(candidates []dtrack.Project) Matcher {
+	return newIndexMatcher("purl", "PURL", func(p dtrack.Project) string { return p.PURL }, candidates)
+}
+
+// NewCPEMatcher matches projects by their CPE.
+func NewCPEMatcher(candidates []dtrack.Project) Matcher {
+	return newIndexMatcher("cpe", "CPE", func(p dtrack.Project) string { return p.CPE }, candidates)
+}
+
+// NewSWIDMatcher matches projects by their SWID tag ID.
+func NewSWIDMatcher(candidates []dtrack.Project) Matcher {
+	return newIndexMatcher("swid", "SWID tag ID", func(p dtrack.Project) string { return p.SWIDTagID }, candidates)
+}
+
+// tagMatchPrefix is the tag prefix dtdiff looks for when matching projects
+// by a shared "dtdiff:key=..." tag.
+const tagMatchPrefix = "dtdiff:key="
+
+// NewTagMatcher matches projects sharing a "dtdiff:key=<value>" tag.
+func NewTagMatcher(candidates []dtrack.Project) Matcher {
+	return newIndexMatcher("tag", tagMatchPrefix+"... tag", func(p dtrack.Project) string {
+		for _, tag := range p.Tags {
+			if strings.HasPrefix(tag.Name, tagMatchPrefix) {
+				return strings.TrimPrefix(tag.Name, tagMatchPrefix)
+			}
+		}
+
+		return ""
+	}, candidates)
+}
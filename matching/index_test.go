@@ -0,0 +1,138 @@
+package matching
+
+import (
+	"context"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+func TestPURLMatcher(t *testing.T) {
+	candidates := []dtrack.Project{
+		{Name: "b-project", PURL: "pkg:maven/foo/bar@1.0.0"},
+	}
+	matcher := NewPURLMatcher(candidates)
+
+	match, ok, err := matcher.Match(context.Background(), dtrack.Project{PURL: "pkg:maven/foo/bar@1.0.0"})
+	if err != nil {
+		t.Fatalf("Match returned error: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if match.Name != "b-project" {
+		t.Errorf("match.Name = %q, want %q", match.Name, "b-project")
+	}
+}
+
+func TestCPEMatcher(t *testing.T) {
+	candidates := []dtrack.Project{
+		{Name: "b-project", CPE: "cpe:2.3:a:foo:bar:1.0.0:*:*:*:*:*:*:*"},
+	}
+	matcher := NewCPEMatcher(candidates)
+
+	match, ok, err := matcher.Match(context.Background(), dtrack.Project{CPE: "cpe:2.3:a:foo:bar:1.0.0:*:*:*:*:*:*:*"})
+	if err != nil {
+		t.Fatalf("Match returned error: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if match.Name != "b-project" {
+		t.Errorf("match.Name = %q, want %q", match.Name, "b-project")
+	}
+}
+
+// TestSWIDMatcher guards against the SWIDTagID field-name typo that once
+// broke this matcher at compile time (see nscuro/dtdiff#chunk0-3).
+func TestSWIDMatcher(t *testing.T) {
+	candidates := []dtrack.Project{
+		{Name: "b-project", SWIDTagID: "swid-tag-1"},
+	}
+	matcher := NewSWIDMatcher(candidates)
+
+	match, ok, err := matcher.Match(context.Background(), dtrack.Project{SWIDTagID: "swid-tag-1"})
+	if err != nil {
+		t.Fatalf("Match returned error: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if match.Name != "b-project" {
+		t.Errorf("match.Name = %q, want %q", match.Name, "b-project")
+	}
+}
+
+func TestIndexMatcher_NoMatchWhenProjectHasNoField(t *testing.T) {
+	matcher := NewPURLMatcher([]dtrack.Project{{PURL: "pkg:maven/foo/bar@1.0.0"}})
+
+	_, ok, err := matcher.Match(context.Background(), dtrack.Project{})
+	if err != nil {
+		t.Fatalf("Match returned error: %v", err)
+	}
+	if ok {
+		t.Fatal("expected no match for a project with an empty PURL")
+	}
+}
+
+func TestIndexMatcher_MatchReason(t *testing.T) {
+	matcher := NewPURLMatcher([]dtrack.Project{{PURL: "pkg:maven/foo/bar@1.0.0"}})
+
+	tests := []struct {
+		name    string
+		project dtrack.Project
+		want    string
+	}{
+		{
+			name:    "no field value",
+			project: dtrack.Project{},
+			want:    "purl: project has no PURL",
+		},
+		{
+			name:    "no candidate with that value",
+			project: dtrack.Project{PURL: "pkg:maven/other/thing@1.0.0"},
+			want:    `purl: no project in B with PURL "pkg:maven/other/thing@1.0.0"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matcher.(ReasonProvider).MatchReason(context.Background(), tt.project)
+			if got != tt.want {
+				t.Errorf("MatchReason = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagMatcher_StripsPrefix(t *testing.T) {
+	candidates := []dtrack.Project{
+		{Name: "b-project", Tags: []dtrack.Tag{{Name: "unrelated"}, {Name: "dtdiff:key=shared-key"}}},
+	}
+	matcher := NewTagMatcher(candidates)
+
+	match, ok, err := matcher.Match(context.Background(), dtrack.Project{
+		Tags: []dtrack.Tag{{Name: "dtdiff:key=shared-key"}},
+	})
+	if err != nil {
+		t.Fatalf("Match returned error: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if match.Name != "b-project" {
+		t.Errorf("match.Name = %q, want %q", match.Name, "b-project")
+	}
+}
+
+func TestTagMatcher_NoMatchWithoutPrefixedTag(t *testing.T) {
+	matcher := NewTagMatcher([]dtrack.Project{{Tags: []dtrack.Tag{{Name: "dtdiff:key=shared-key"}}}})
+
+	_, ok, err := matcher.Match(context.Background(), dtrack.Project{Tags: []dtrack.Tag{{Name: "unrelated"}}})
+	if err != nil {
+		t.Fatalf("Match returned error: %v", err)
+	}
+	if ok {
+		t.Fatal("expected no match for a project without a dtdiff:key= tag")
+	}
+}
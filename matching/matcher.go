@@ -0,0 +1,57 @@
+// Package matching provides strategies for identifying the project in
+// instance B that corresponds to a given project from instance A.
+package matching
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// Matcher attempts to find the project that corresponds to a given project
+// from instance A among instance B's projects.
+type Matcher interface {
+	// Match returns the matching project and true, or the zero value and
+	// false if project could not be matched.
+	Match(ctx context.Context, project dtrack.Project) (match dtrack.Project, ok bool, err error)
+
+	// Name identifies the strategy, used in unmatched-project reports.
+	Name() string
+}
+
+// ReasonProvider is implemented by Matchers that can explain why a specific
+// project failed to match, beyond just their Name. Callers should only
+// invoke MatchReason after Match has returned ok=false, err=nil for the same
+// project.
+type ReasonProvider interface {
+	MatchReason(ctx context.Context, project dtrack.Project) string
+}
+
+// New builds the Matcher for the given strategy name. bProjects is required
+// by every strategy except "name-version", which looks up matches directly
+// via bClient.
+func New(strategy string, bClient *dtrack.Client, bProjects []dtrack.Project) (Matcher, error) {
+	switch strategy {
+	case "", "name-version":
+		return NewNameVersionMatcher(bClient), nil
+	case "purl":
+		return NewPURLMatcher(bProjects), nil
+	case "tag":
+		return NewTagMatcher(bProjects), nil
+	case "cpe":
+		return NewCPEMatcher(bProjects), nil
+	case "swid":
+		return NewSWIDMatcher(bProjects), nil
+	case "composite":
+		return NewCompositeMatcher(
+			NewNameVersionMatcher(bClient),
+			NewPURLMatcher(bProjects),
+			NewTagMatcher(bProjects),
+			NewCPEMatcher(bProjects),
+			NewSWIDMatcher(bProjects),
+		), nil
+	default:
+		return nil, fmt.Errorf("unknown match strategy %q", strategy)
+	}
+}
@@ -0,0 +1,45 @@
+package matching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// NameVersionMatcher matches projects by exact name and version, via
+// instance B's project lookup API. This is dtdiff's original, default
+// matching strategy.
+type NameVersionMatcher struct {
+	client *dtrack.Client
+}
+
+func NewNameVersionMatcher(client *dtrack.Client) *NameVersionMatcher {
+	return &NameVersionMatcher{client: client}
+}
+
+func (m *NameVersionMatcher) Name() string {
+	return "name-version"
+}
+
+func (m *NameVersionMatcher) Match(ctx context.Context, project dtrack.Project) (dtrack.Project, bool, error) {
+	match, err := m.client.Project.Lookup(ctx, project.Name, project.Version)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return dtrack.Project{}, false, nil
+		}
+
+		return dtrack.Project{}, false, fmt.Errorf("failed to lookup project %s/%s: %w", project.Name, project.Version, err)
+	}
+
+	return match, true, nil
+}
+
+// MatchReason explains a miss: no project named project.Name/project.Version
+// exists on instance B.
+func (m *NameVersionMatcher) MatchReason(_ context.Context, project dtrack.Project) string {
+	return fmt.Sprintf("name-version: no project in B named %s/%s", project.Name, project.Version)
+}
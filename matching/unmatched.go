@@ -0,0 +1,37 @@
+package matching
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// Unmatched records a project from instance A that could not be matched to
+// any project in instance B by the configured strategy.
+type Unmatched struct {
+	Project  dtrack.Project `json:"project"`
+	Strategy string         `json:"strategy"`
+
+	// Reason explains why the strategy missed, e.g. a sub-matcher breakdown
+	// for the composite strategy. Empty if the matcher doesn't implement
+	// ReasonProvider.
+	Reason string `json:"reason,omitempty"`
+}
+
+// WriteUnmatchedReport writes unmatched as an indented JSON array to path,
+// so that coverage gaps between the two instances can be inspected
+// independently of the per-project diff output.
+func WriteUnmatchedReport(path string, unmatched []Unmatched) error {
+	data, err := json.MarshalIndent(unmatched, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal unmatched-projects report: %w", err)
+	}
+
+	if err = os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write unmatched-projects report %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package normalize
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// AliasCollapseFilter collapses findings for the same component that refer
+// to the same vulnerability under different source identifiers (CVE, GHSA,
+// OSV) into a single canonical finding, so that e.g. OSS Index and Snyk
+// reporting the same CVE don't show up as two distinct findings.
+//
+// Which duplicate survives the collapse depends on finding order, which can
+// differ between instance A and B (e.g. A's first duplicate is the OSS
+// Index record, B's is the Snyk record). If the survivor kept its own
+// VulnID, diff.Compare would then key on two different VulnIDs for what is
+// really the same vulnerability, reporting a spurious removed+added pair.
+// To converge regardless of which duplicate wins, the survivor's VulnID is
+// rewritten to the alias-group key, and its Attribution.AnalyzerIdentity is
+// cleared, so both sides key the group's finding identically. The survivor's
+// other fields are kept as they were, so if the duplicates differ in those,
+// diff.Compare reports the group as changed.
+type AliasCollapseFilter struct{}
+
+func NewAliasCollapseFilter() *AliasCollapseFilter {
+	return &AliasCollapseFilter{}
+}
+
+type aliasGroupKey struct {
+	component string
+	alias     string
+}
+
+func (f *AliasCollapseFilter) Normalize(_ context.Context, findings []dtrack.Finding) []dtrack.Finding {
+	order := make([]aliasGroupKey, 0, len(findings))
+	canonical := make(map[aliasGroupKey]dtrack.Finding, len(findings))
+
+	for _, finding := range findings {
+		key := aliasGroupKey{component: finding.Component.PURL, alias: primaryAlias(finding)}
+		if _, seen := canonical[key]; !seen {
+			order = append(order, key)
+			canonical[key] = finding
+		}
+	}
+
+	collapsed := make([]dtrack.Finding, 0, len(order))
+	for _, key := range order {
+		finding := canonical[key]
+
+		// Normalize the survivor onto the alias-group key so that instance A
+		// and instance B converge on the same finding key even if they picked
+		// a different duplicate as the survivor.
+		finding.Vulnerability.VulnID = key.alias
+		finding.Attribution.AnalyzerIdentity = ""
+
+		collapsed = append(collapsed, finding)
+	}
+
+	return collapsed
+}
+
+// primaryAlias returns the most canonical identifier for a finding's
+// vulnerability: its first CVE alias, else its first GHSA alias, else its
+// first OSV alias, else the vulnerability's own ID.
+func primaryAlias(finding dtrack.Finding) string {
+	var ghsa, osv string
+
+	for _, alias := range finding.Vulnerability.Aliases {
+		if alias.CveID != "" {
+			return alias.CveID
+		}
+		if ghsa == "" && alias.GhsaID != "" {
+			ghsa = alias.GhsaID
+		}
+		if osv == "" && alias.OsvID != "" {
+			osv = alias.OsvID
+		}
+	}
+
+	if ghsa != "" {
+		return ghsa
+	}
+	if osv != "" {
+		return osv
+	}
+
+	return finding.Vulnerability.VulnID
+}
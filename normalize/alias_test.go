@@ -0,0 +1,82 @@
+package normalize
+
+import (
+	"context"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+func componentPURL() string {
+	return "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"
+}
+
+// ossIndexFinding and snykFinding represent the same vulnerability reported
+// for the same component by two different analyzers, each under its own
+// VulnID, with a shared CVE alias.
+func ossIndexFinding() dtrack.Finding {
+	var f dtrack.Finding
+	f.Component.PURL = componentPURL()
+	f.Vulnerability.VulnID = "CVE-2021-44228"
+	f.Vulnerability.Aliases = append(f.Vulnerability.Aliases, dtrack.VulnerabilityAlias{CveID: "CVE-2021-44228"})
+	f.Attribution.AnalyzerIdentity = "OSSINDEX_ANALYZER"
+	return f
+}
+
+func snykFinding() dtrack.Finding {
+	var f dtrack.Finding
+	f.Component.PURL = componentPURL()
+	f.Vulnerability.VulnID = "SNYK-JAVA-ORGAPACHELOGGINGLOG4J-1712470"
+	f.Vulnerability.Aliases = append(f.Vulnerability.Aliases, dtrack.VulnerabilityAlias{CveID: "CVE-2021-44228"})
+	f.Attribution.AnalyzerIdentity = "SNYK_ANALYZER"
+	return f
+}
+
+// TestAliasCollapseFilter_ConvergesRegardlessOfDuplicateOrder guards against
+// the collapse filter picking an arbitrary survivor per side: if instance A
+// happens to see the OSS Index record first and instance B happens to see
+// the Snyk record first, both sides must still collapse to an identical
+// finding, or the diff would report a spurious removed+added pair.
+func TestAliasCollapseFilter_ConvergesRegardlessOfDuplicateOrder(t *testing.T) {
+	filter := NewAliasCollapseFilter()
+
+	aFindings := filter.Normalize(context.Background(), []dtrack.Finding{ossIndexFinding(), snykFinding()})
+	bFindings := filter.Normalize(context.Background(), []dtrack.Finding{snykFinding(), ossIndexFinding()})
+
+	if len(aFindings) != 1 {
+		t.Fatalf("expected instance A to collapse to 1 finding, got %d", len(aFindings))
+	}
+	if len(bFindings) != 1 {
+		t.Fatalf("expected instance B to collapse to 1 finding, got %d", len(bFindings))
+	}
+
+	if aFindings[0].Vulnerability.VulnID != "CVE-2021-44228" {
+		t.Errorf("instance A survivor VulnID = %q, want the alias-group key %q", aFindings[0].Vulnerability.VulnID, "CVE-2021-44228")
+	}
+	if bFindings[0].Vulnerability.VulnID != "CVE-2021-44228" {
+		t.Errorf("instance B survivor VulnID = %q, want the alias-group key %q", bFindings[0].Vulnerability.VulnID, "CVE-2021-44228")
+	}
+
+	if aFindings[0].Attribution.AnalyzerIdentity != "" {
+		t.Errorf("instance A survivor kept analyzer identity %q, want it cleared", aFindings[0].Attribution.AnalyzerIdentity)
+	}
+	if bFindings[0].Attribution.AnalyzerIdentity != "" {
+		t.Errorf("instance B survivor kept analyzer identity %q, want it cleared", bFindings[0].Attribution.AnalyzerIdentity)
+	}
+}
+
+func TestAliasCollapseFilter_NoAliasesFallsBackToOwnVulnID(t *testing.T) {
+	var f dtrack.Finding
+	f.Component.PURL = componentPURL()
+	f.Vulnerability.VulnID = "GHSA-jfh8-c2jp-5v3q"
+
+	filter := NewAliasCollapseFilter()
+	result := filter.Normalize(context.Background(), []dtrack.Finding{f})
+
+	if len(result) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(result))
+	}
+	if result[0].Vulnerability.VulnID != "GHSA-jfh8-c2jp-5v3q" {
+		t.Errorf("VulnID = %q, want unchanged %q", result[0].Vulnerability.VulnID, "GHSA-jfh8-c2jp-5v3q")
+	}
+}
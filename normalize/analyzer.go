@@ -0,0 +1,36 @@
+package normalize
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// AnalyzerFilter drops findings attributed to an analyzer that isn't
+// enabled on both compared instances. Such findings are expected to differ
+// and would otherwise drown out true discrepancies.
+type AnalyzerFilter struct {
+	enabled map[string]struct{}
+}
+
+// NewAnalyzerFilter builds an AnalyzerFilter that only keeps findings
+// attributed to one of enabledAnalyzers.
+func NewAnalyzerFilter(enabledAnalyzers []string) *AnalyzerFilter {
+	enabled := make(map[string]struct{}, len(enabledAnalyzers))
+	for _, a := range enabledAnalyzers {
+		enabled[a] = struct{}{}
+	}
+
+	return &AnalyzerFilter{enabled: enabled}
+}
+
+func (f *AnalyzerFilter) Normalize(_ context.Context, findings []dtrack.Finding) []dtrack.Finding {
+	kept := make([]dtrack.Finding, 0, len(findings))
+	for _, finding := range findings {
+		if _, ok := f.enabled[string(finding.Attribution.AnalyzerIdentity)]; ok {
+			kept = append(kept, finding)
+		}
+	}
+
+	return kept
+}
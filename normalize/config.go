@@ -0,0 +1,40 @@
+package normalize
+
+// Config declares which normalization filters are enabled, loaded from the
+// same config file as reporters.
+type Config struct {
+	// EnabledAnalyzers lists the AnalyzerIdentity values enabled on both
+	// compared instances; findings attributed to any other analyzer are
+	// dropped before diffing. Leave empty to disable this filter.
+	EnabledAnalyzers []string `yaml:"enabledAnalyzers,omitempty" json:"enabledAnalyzers,omitempty"`
+
+	// CollapseAliases collapses findings for the same component that refer
+	// to the same vulnerability under different source identifiers into a
+	// single canonical finding.
+	CollapseAliases bool `yaml:"collapseAliases,omitempty" json:"collapseAliases,omitempty"`
+
+	// IgnoreSuppressed drops findings that have been suppressed by the
+	// analysis workflow.
+	IgnoreSuppressed bool `yaml:"ignoreSuppressed,omitempty" json:"ignoreSuppressed,omitempty"`
+}
+
+// Pipeline builds the Normalizers described by the config, in the order
+// they should run. It does not include DynamicFieldsNormalizer, which
+// always runs ahead of these regardless of configuration.
+func (c Config) Pipeline() Pipeline {
+	var pipeline Pipeline
+
+	if c.IgnoreSuppressed {
+		pipeline = append(pipeline, NewSuppressedFilter())
+	}
+
+	if len(c.EnabledAnalyzers) > 0 {
+		pipeline = append(pipeline, NewAnalyzerFilter(c.EnabledAnalyzers))
+	}
+
+	if c.CollapseAliases {
+		pipeline = append(pipeline, NewAliasCollapseFilter())
+	}
+
+	return pipeline
+}
@@ -0,0 +1,30 @@
+package normalize
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+)
+
+// DynamicFieldsNormalizer null-ifies fields that will always differ between
+// instances due to their dynamic nature, such as UUIDs and timestamps. It's
+// always the first stage of the pipeline, ahead of any configured filters.
+type DynamicFieldsNormalizer struct{}
+
+func NewDynamicFieldsNormalizer() *DynamicFieldsNormalizer {
+	return &DynamicFieldsNormalizer{}
+}
+
+func (n *DynamicFieldsNormalizer) Normalize(_ context.Context, findings []dtrack.Finding) []dtrack.Finding {
+	for i := range findings {
+		findings[i].Component.UUID = uuid.Nil
+		findings[i].Component.Project = uuid.Nil
+		findings[i].Vulnerability.UUID = uuid.Nil
+		findings[i].Attribution.UUID = uuid.Nil
+		findings[i].Attribution.AttributedOn = 0
+		findings[i].Matrix = ""
+	}
+
+	return findings
+}
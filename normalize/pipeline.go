@@ -0,0 +1,28 @@
+// Package normalize provides a pipeline of transformations applied to a
+// project's raw findings before they're diffed, so that expected noise
+// (dynamic fields, analyzer-set differences, duplicate vulnerability
+// sources, suppressions) doesn't drown out true discrepancies.
+package normalize
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// Normalizer transforms a project's findings ahead of diffing.
+type Normalizer interface {
+	Normalize(ctx context.Context, findings []dtrack.Finding) []dtrack.Finding
+}
+
+// Pipeline runs a sequence of Normalizers in order, feeding the output of
+// one into the next.
+type Pipeline []Normalizer
+
+func (p Pipeline) Normalize(ctx context.Context, findings []dtrack.Finding) []dtrack.Finding {
+	for _, n := range p {
+		findings = n.Normalize(ctx, findings)
+	}
+
+	return findings
+}
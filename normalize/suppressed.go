@@ -0,0 +1,27 @@
+package normalize
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// SuppressedFilter drops findings that have been suppressed by the
+// analysis workflow. A suppression made on one instance but not mirrored
+// on the other is a triage decision, not a scan discrepancy.
+type SuppressedFilter struct{}
+
+func NewSuppressedFilter() *SuppressedFilter {
+	return &SuppressedFilter{}
+}
+
+func (f *SuppressedFilter) Normalize(_ context.Context, findings []dtrack.Finding) []dtrack.Finding {
+	kept := make([]dtrack.Finding, 0, len(findings))
+	for _, finding := range findings {
+		if !finding.Analysis.Suppressed {
+			kept = append(kept, finding)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,85 @@
+package reporting
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nscuro/dtdiff/normalize"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the root of the reporting configuration file consumed via
+// -reporting-config. Each non-nil reporter section enables and configures
+// the corresponding Reporter; Filters configures the normalization pipeline
+// applied to findings before they're diffed.
+type Config struct {
+	HTML    *HTMLConfig       `yaml:"html,omitempty" json:"html,omitempty"`
+	Jira    *JiraConfig       `yaml:"jira,omitempty" json:"jira,omitempty"`
+	GitHub  *GitHubConfig     `yaml:"github,omitempty" json:"github,omitempty"`
+	GitLab  *GitLabConfig     `yaml:"gitlab,omitempty" json:"gitlab,omitempty"`
+	Filters *normalize.Config `yaml:"filters,omitempty" json:"filters,omitempty"`
+}
+
+// Pipeline builds the Normalizer pipeline described by the config's filters
+// section, always starting with dynamic-field clearing.
+func (c Config) Pipeline() normalize.Pipeline {
+	pipeline := normalize.Pipeline{normalize.NewDynamicFieldsNormalizer()}
+
+	if c.Filters != nil {
+		pipeline = append(pipeline, c.Filters.Pipeline()...)
+	}
+
+	return pipeline
+}
+
+// LoadConfig reads and parses a reporting configuration file. The file may
+// be either YAML or JSON, since JSON is a subset of YAML.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reporting config %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err = yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse reporting config %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// Reporters builds the set of Reporter implementations enabled by the
+// config.
+func (c Config) Reporters() ([]Reporter, error) {
+	var reporters []Reporter
+
+	if c.HTML != nil {
+		reporters = append(reporters, NewHTMLReporter(*c.HTML))
+	}
+
+	if c.Jira != nil {
+		r, err := NewJiraReporter(*c.Jira)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize jira reporter: %w", err)
+		}
+		reporters = append(reporters, r)
+	}
+
+	if c.GitHub != nil {
+		r, err := NewGitHubReporter(*c.GitHub)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize github reporter: %w", err)
+		}
+		reporters = append(reporters, r)
+	}
+
+	if c.GitLab != nil {
+		r, err := NewGitLabReporter(*c.GitLab)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize gitlab reporter: %w", err)
+		}
+		reporters = append(reporters, r)
+	}
+
+	return reporters, nil
+}
@@ -0,0 +1,112 @@
+package reporting
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nscuro/dtdiff/normalize"
+)
+
+func writeConfigFile(t *testing.T, name, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+
+	return path
+}
+
+func TestLoadConfig_YAML(t *testing.T) {
+	path := writeConfigFile(t, "reporting.yaml", `
+html:
+  directory: /tmp/diffs
+filters:
+  ignoreSuppressed: true
+`)
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig returned error: %v", err)
+	}
+
+	if cfg.HTML == nil {
+		t.Fatal("expected HTML config to be populated")
+	}
+	if cfg.HTML.Directory != "/tmp/diffs" {
+		t.Errorf("HTML.Directory = %q, want %q", cfg.HTML.Directory, "/tmp/diffs")
+	}
+	if cfg.Filters == nil || !cfg.Filters.IgnoreSuppressed {
+		t.Error("expected Filters.IgnoreSuppressed to be true")
+	}
+}
+
+func TestLoadConfig_JSON(t *testing.T) {
+	path := writeConfigFile(t, "reporting.json", `{
+		"gitlab": {"projectId": "42", "token": "secret"}
+	}`)
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig returned error: %v", err)
+	}
+
+	if cfg.GitLab == nil {
+		t.Fatal("expected GitLab config to be populated")
+	}
+	if cfg.GitLab.ProjectID != "42" {
+		t.Errorf("GitLab.ProjectID = %q, want %q", cfg.GitLab.ProjectID, "42")
+	}
+}
+
+func TestLoadConfig_MissingFile(t *testing.T) {
+	if _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Fatal("expected an error for a missing config file")
+	}
+}
+
+func TestConfig_Pipeline(t *testing.T) {
+	t.Run("no filters always includes dynamic fields normalizer", func(t *testing.T) {
+		pipeline := Config{}.Pipeline()
+		if len(pipeline) != 1 {
+			t.Fatalf("len(pipeline) = %d, want 1", len(pipeline))
+		}
+	})
+
+	t.Run("filters are appended after dynamic fields normalizer", func(t *testing.T) {
+		cfg := Config{Filters: &normalize.Config{IgnoreSuppressed: true}}
+		pipeline := cfg.Pipeline()
+		if len(pipeline) != 2 {
+			t.Fatalf("len(pipeline) = %d, want 2", len(pipeline))
+		}
+	})
+}
+
+func TestConfig_Reporters(t *testing.T) {
+	cfg := Config{
+		HTML:   &HTMLConfig{Directory: "/tmp/diffs"},
+		Jira:   &JiraConfig{BaseURL: "https://jira.example.com", ProjectKey: "PROJ"},
+		GitHub: &GitHubConfig{Owner: "foo", Repo: "bar"},
+		GitLab: &GitLabConfig{ProjectID: "42"},
+	}
+
+	reporters, err := cfg.Reporters()
+	if err != nil {
+		t.Fatalf("Reporters returned error: %v", err)
+	}
+	if len(reporters) != 4 {
+		t.Fatalf("len(reporters) = %d, want 4", len(reporters))
+	}
+}
+
+func TestConfig_Reporters_NoneConfigured(t *testing.T) {
+	reporters, err := Config{}.Reporters()
+	if err != nil {
+		t.Fatalf("Reporters returned error: %v", err)
+	}
+	if len(reporters) != 0 {
+		t.Fatalf("len(reporters) = %d, want 0", len(reporters))
+	}
+}
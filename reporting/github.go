@@ -0,0 +1,46 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v56/github"
+	"golang.org/x/oauth2"
+)
+
+// GitHubConfig configures a GitHubReporter.
+type GitHubConfig struct {
+	Token  string   `yaml:"token" json:"token"`
+	Owner  string   `yaml:"owner" json:"owner"`
+	Repo   string   `yaml:"repo" json:"repo"`
+	Labels []string `yaml:"labels,omitempty" json:"labels,omitempty"`
+}
+
+// GitHubReporter files one GitHub issue per differing project.
+type GitHubReporter struct {
+	client *github.Client
+	cfg    GitHubConfig
+}
+
+func NewGitHubReporter(cfg GitHubConfig) (*GitHubReporter, error) {
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cfg.Token})
+	client := github.NewClient(oauth2.NewClient(context.Background(), ts))
+
+	return &GitHubReporter{client: client, cfg: cfg}, nil
+}
+
+func (r *GitHubReporter) Report(ctx context.Context, diff ProjectDiff) error {
+	title := issueTitle(diff)
+	body := issueBody(diff)
+
+	_, _, err := r.client.Issues.Create(ctx, r.cfg.Owner, r.cfg.Repo, &github.IssueRequest{
+		Title:  &title,
+		Body:   &body,
+		Labels: &r.cfg.Labels,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create github issue for %s/%s: %w", diff.AProject.Name, diff.AProject.Version, err)
+	}
+
+	return nil
+}
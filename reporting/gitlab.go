@@ -0,0 +1,52 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// GitLabConfig configures a GitLabReporter.
+type GitLabConfig struct {
+	BaseURL   string   `yaml:"baseUrl,omitempty" json:"baseUrl,omitempty"`
+	Token     string   `yaml:"token" json:"token"`
+	ProjectID string   `yaml:"projectId" json:"projectId"`
+	Labels    []string `yaml:"labels,omitempty" json:"labels,omitempty"`
+}
+
+// GitLabReporter files one GitLab issue per differing project.
+type GitLabReporter struct {
+	client *gitlab.Client
+	cfg    GitLabConfig
+}
+
+func NewGitLabReporter(cfg GitLabConfig) (*GitLabReporter, error) {
+	var opts []gitlab.ClientOptionFunc
+	if cfg.BaseURL != "" {
+		opts = append(opts, gitlab.WithBaseURL(cfg.BaseURL))
+	}
+
+	client, err := gitlab.NewClient(cfg.Token, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize gitlab client: %w", err)
+	}
+
+	return &GitLabReporter{client: client, cfg: cfg}, nil
+}
+
+func (r *GitLabReporter) Report(ctx context.Context, diff ProjectDiff) error {
+	title := issueTitle(diff)
+	body := issueBody(diff)
+
+	_, _, err := r.client.Issues.CreateIssue(r.cfg.ProjectID, &gitlab.CreateIssueOptions{
+		Title:       &title,
+		Description: &body,
+		Labels:      (*gitlab.LabelOptions)(&r.cfg.Labels),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to create gitlab issue for %s/%s: %w", diff.AProject.Name, diff.AProject.Version, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// HTMLConfig configures an HTMLReporter.
+type HTMLConfig struct {
+	// Directory is the path diff files are written to.
+	Directory string `yaml:"directory" json:"directory"`
+}
+
+// HTMLReporter writes each ProjectDiff to its own HTML file. It's the direct
+// successor of the file sink dtdiff used before reporters existed, kept
+// around as the default for local, human-driven usage.
+type HTMLReporter struct {
+	dir string
+}
+
+func NewHTMLReporter(cfg HTMLConfig) *HTMLReporter {
+	return &HTMLReporter{dir: cfg.Directory}
+}
+
+func (r *HTMLReporter) local() {}
+
+func (r *HTMLReporter) Report(_ context.Context, diff ProjectDiff) error {
+	fileName := strings.ReplaceAll(fmt.Sprintf("%s_%s.html", diff.AProject.Name, diff.AProject.Version), "/", "-")
+	path := filepath.Join(r.dir, fileName)
+
+	if err := os.WriteFile(path, []byte(fmt.Sprintf("<pre>%s</pre>", diff.Diff)), os.ModePerm); err != nil {
+		return fmt.Errorf("failed to write diff file %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+// JiraConfig configures a JiraReporter.
+type JiraConfig struct {
+	BaseURL    string `yaml:"baseUrl" json:"baseUrl"`
+	Username   string `yaml:"username" json:"username"`
+	APIToken   string `yaml:"apiToken" json:"apiToken"`
+	ProjectKey string `yaml:"projectKey" json:"projectKey"`
+	IssueType  string `yaml:"issueType" json:"issueType"`
+}
+
+// JiraReporter files one Jira issue per differing project.
+type JiraReporter struct {
+	client *jira.Client
+	cfg    JiraConfig
+}
+
+func NewJiraReporter(cfg JiraConfig) (*JiraReporter, error) {
+	tp := jira.BasicAuthTransport{
+		Username: cfg.Username,
+		Password: cfg.APIToken,
+	}
+
+	client, err := jira.NewClient(tp.Client(), cfg.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize jira client for %s: %w", cfg.BaseURL, err)
+	}
+
+	if cfg.IssueType == "" {
+		cfg.IssueType = "Bug"
+	}
+
+	return &JiraReporter{client: client, cfg: cfg}, nil
+}
+
+func (r *JiraReporter) Report(ctx context.Context, diff ProjectDiff) error {
+	issue := &jira.Issue{
+		Fields: &jira.IssueFields{
+			Project: jira.Project{
+				Key: r.cfg.ProjectKey,
+			},
+			Type: jira.IssueType{
+				Name: r.cfg.IssueType,
+			},
+			Summary:     issueTitle(diff),
+			Description: issueBody(diff),
+		},
+	}
+
+	req, err := r.client.NewRequestWithContext(ctx, http.MethodPost, "rest/api/2/issue", issue)
+	if err != nil {
+		return fmt.Errorf("failed to build jira issue request: %w", err)
+	}
+
+	var created jira.Issue
+	if _, err = r.client.Do(req, &created); err != nil {
+		return fmt.Errorf("failed to create jira issue for %s/%s: %w", diff.AProject.Name, diff.AProject.Version, err)
+	}
+
+	return nil
+}
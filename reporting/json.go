@@ -0,0 +1,45 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nscuro/dtdiff/diff"
+)
+
+// JSONConfig configures a JSONReporter.
+type JSONConfig struct {
+	// Directory is the path diff files are written to.
+	Directory string `yaml:"directory" json:"directory"`
+}
+
+// JSONReporter writes each ProjectDiff's structured finding diff to its own
+// JSON file.
+type JSONReporter struct {
+	dir string
+}
+
+func NewJSONReporter(cfg JSONConfig) *JSONReporter {
+	return &JSONReporter{dir: cfg.Directory}
+}
+
+func (r *JSONReporter) local() {}
+
+func (r *JSONReporter) Report(_ context.Context, projectDiff ProjectDiff) error {
+	data, err := diff.ToJSON([]diff.ProjectDiff{projectDiff.Structured})
+	if err != nil {
+		return fmt.Errorf("failed to marshal diff: %w", err)
+	}
+
+	fileName := strings.ReplaceAll(fmt.Sprintf("%s_%s.json", projectDiff.AProject.Name, projectDiff.AProject.Version), "/", "-")
+	path := filepath.Join(r.dir, fileName)
+
+	if err = os.WriteFile(path, data, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to write diff file %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nscuro/dtdiff/diff"
+)
+
+// MarkdownConfig configures a MarkdownReporter.
+type MarkdownConfig struct {
+	// Directory is the path diff files are written to.
+	Directory string `yaml:"directory" json:"directory"`
+}
+
+// MarkdownReporter writes each ProjectDiff's structured finding diff to its
+// own Markdown file.
+type MarkdownReporter struct {
+	dir string
+}
+
+func NewMarkdownReporter(cfg MarkdownConfig) *MarkdownReporter {
+	return &MarkdownReporter{dir: cfg.Directory}
+}
+
+func (r *MarkdownReporter) local() {}
+
+func (r *MarkdownReporter) Report(_ context.Context, projectDiff ProjectDiff) error {
+	md := diff.ToMarkdown([]diff.ProjectDiff{projectDiff.Structured})
+
+	fileName := strings.ReplaceAll(fmt.Sprintf("%s_%s.md", projectDiff.AProject.Name, projectDiff.AProject.Version), "/", "-")
+	path := filepath.Join(r.dir, fileName)
+
+	if err := os.WriteFile(path, []byte(md), os.ModePerm); err != nil {
+		return fmt.Errorf("failed to write diff file %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package reporting
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/nscuro/dtdiff/diff"
+)
+
+// ProjectDiff describes the outcome of comparing the findings of a single
+// project that exists in both of the compared Dependency-Track instances.
+type ProjectDiff struct {
+	// AProject and BProject are the matched project in instance A and B
+	// respectively.
+	AProject dtrack.Project
+	BProject dtrack.Project
+
+	// AFindings and BFindings are the findings retrieved from instance A
+	// and B, with dynamic fields cleared and a stable ordering applied.
+	AFindings []dtrack.Finding
+	BFindings []dtrack.Finding
+
+	// Diff is the human-readable representation of the difference between
+	// AFindings and BFindings.
+	Diff string
+
+	// Structured is the finding-level diff model of AFindings and BFindings,
+	// keyed by component PURL and vulnerability ID. It is computed separately
+	// from Diff. Reporters that emit structured output (JSON, Markdown, SARIF)
+	// build from this instead of Diff.
+	Structured diff.ProjectDiff
+
+	// Fingerprint is a stable hash over AFindings and BFindings. It does not
+	// change as long as the findings themselves don't, regardless of how
+	// often dtdiff is run, and is used by reporters to identify issues that
+	// belong to the same project pair across runs.
+	Fingerprint string
+}
+
+// Reporter delivers a ProjectDiff to some external system, such as an issue
+// tracker or a file on disk.
+type Reporter interface {
+	Report(ctx context.Context, diff ProjectDiff) error
+}
+
+// LocalReporter is implemented by reporters that write plain files to disk
+// rather than filing tickets in an external tracker. They're exempt from
+// the diff-dedup cache, since regenerating a file is cheap and still useful
+// for local inspection even when no tracker issue would be filed.
+type LocalReporter interface {
+	Reporter
+	local()
+}
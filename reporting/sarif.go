@@ -0,0 +1,45 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nscuro/dtdiff/diff"
+)
+
+// SARIFConfig configures a SARIFReporter.
+type SARIFConfig struct {
+	// Directory is the path diff files are written to.
+	Directory string `yaml:"directory" json:"directory"`
+}
+
+// SARIFReporter writes each ProjectDiff's structured finding diff to its own
+// SARIF 2.1.0 file.
+type SARIFReporter struct {
+	dir string
+}
+
+func NewSARIFReporter(cfg SARIFConfig) *SARIFReporter {
+	return &SARIFReporter{dir: cfg.Directory}
+}
+
+func (r *SARIFReporter) local() {}
+
+func (r *SARIFReporter) Report(_ context.Context, projectDiff ProjectDiff) error {
+	data, err := diff.ToSARIF([]diff.ProjectDiff{projectDiff.Structured})
+	if err != nil {
+		return fmt.Errorf("failed to marshal diff as sarif: %w", err)
+	}
+
+	fileName := strings.ReplaceAll(fmt.Sprintf("%s_%s.sarif", projectDiff.AProject.Name, projectDiff.AProject.Version), "/", "-")
+	path := filepath.Join(r.dir, fileName)
+
+	if err = os.WriteFile(path, data, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to write diff file %s: %w", path, err)
+	}
+
+	return nil
+}
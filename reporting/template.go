@@ -0,0 +1,21 @@
+package reporting
+
+import "fmt"
+
+// issueTitle builds a stable, human-readable title for tracker issues. It's
+// shared across reporters so tickets look consistent regardless of which
+// tracker they end up in.
+func issueTitle(diff ProjectDiff) string {
+	return fmt.Sprintf("dtdiff: findings differ for %s/%s", diff.AProject.Name, diff.AProject.Version)
+}
+
+// issueBody builds the issue description, including the fingerprint so that
+// trackers which support searching by text can be used to find previously
+// filed issues for the same diff.
+func issueBody(diff ProjectDiff) string {
+	return fmt.Sprintf(
+		"Findings for project *%s/%s* differ between the compared Dependency-Track instances.\n\n"+
+			"Fingerprint: `%s`\n\n```\n%s\n```\n",
+		diff.AProject.Name, diff.AProject.Version, diff.Fingerprint, diff.Diff,
+	)
+}